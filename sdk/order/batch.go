@@ -0,0 +1,208 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// signedOrder is the result of L2-signing a single CreateOrderParams, ready
+// to be serialized into a batch request body.
+type signedOrder struct {
+	index int
+	body  map[string]interface{}
+	err   error
+}
+
+// signOrder computes the StarkEx signable payload for a single order the
+// same way CreateOrder does, without performing the HTTP submission. It
+// shares signAndBuildOrderBody with CreateOrder so batch submissions get
+// the same tick/step/notional validation and NonceStore recording.
+// clientOrderId is supplied by the caller so batch submission can guarantee
+// it is collision-free across the whole batch.
+func (c *Client) signOrder(ctx context.Context, params *CreateOrderParams, metadata *metadatapkg.MetaData, l2Price decimal.Decimal, clientOrderId string) (map[string]interface{}, error) {
+	if params.TimeInForce == "" {
+		switch params.Type {
+		case OrderTypeMarket:
+			params.TimeInForce = string(TimeInForce_IMMEDIATE_OR_CANCEL)
+		case OrderTypeLimit:
+			params.TimeInForce = string(TimeInForce_GOOD_TIL_CANCEL)
+		}
+	}
+
+	body, _, err := c.signAndBuildOrderBody(ctx, params, metadata, l2Price, clientOrderId)
+	return body, err
+}
+
+// CreateOrders signs and submits a batch of orders in a single call. Each
+// order is independently L2-signed in parallel via a worker pool, then the
+// batch is submitted to the createOrderBatch endpoint. The returned slice
+// preserves input ordering; a signing or per-item submission failure is
+// reported in that item's slot rather than aborting the whole batch.
+func (c *Client) CreateOrders(ctx context.Context, paramsList []*CreateOrderParams, metadata *metadatapkg.MetaData, l2Prices []decimal.Decimal) ([]ResultCreateOrder, error) {
+	if len(paramsList) != len(l2Prices) {
+		return nil, fmt.Errorf("paramsList and l2Prices must be the same length")
+	}
+
+	// Reserve a unique clientOrderId per item up front so concurrent signing
+	// below can never collide within the batch.
+	clientOrderIds := reserveClientOrderIds(len(paramsList), internal.GetRandomClientId)
+
+	signed := make([]signedOrder, len(paramsList))
+	var wg sync.WaitGroup
+
+	for i, params := range paramsList {
+		i, params := i, params
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body, err := c.signOrder(ctx, params, metadata, l2Prices[i], clientOrderIds[i])
+			signed[i] = signedOrder{index: i, body: body, err: err}
+		}()
+	}
+	wg.Wait()
+
+	results := make([]ResultCreateOrder, len(paramsList))
+	orders := make([]map[string]interface{}, 0, len(paramsList))
+	orderIndex := make([]int, 0, len(paramsList))
+
+	for i, s := range signed {
+		if s.err != nil {
+			results[i] = ResultCreateOrder{Code: "SIGN_ERROR", ErrorMsg: s.err.Error()}
+			continue
+		}
+		orders = append(orders, s.body)
+		orderIndex = append(orderIndex, i)
+	}
+
+	if len(orders) == 0 {
+		return results, nil
+	}
+
+	url := fmt.Sprintf("%s/api/v1/private/order/createOrderBatch", c.Client.GetBaseURL())
+	resp, err := c.Client.HttpRequest(url, "POST", map[string]interface{}{
+		"accountId": strconv.FormatInt(c.Client.GetAccountID(), 10),
+		"orderList": orders,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order batch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var batchResult struct {
+		Code       string              `json:"code"`
+		ErrorMsg   string              `json:"errorMsg"`
+		ErrorParam interface{}         `json:"errorParam"`
+		Data       []ResultCreateOrder `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &batchResult); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	// A non-SUCCESS batch-level response still carries no per-item outcome
+	// data, so record the batch-level error in each submitted item's slot
+	// rather than discarding the whole call (and the successfully signed
+	// items' results) with a single error.
+	if batchResult.Code != "SUCCESS" {
+		for _, i := range orderIndex {
+			results[i] = ResultCreateOrder{Code: batchResult.Code, ErrorMsg: batchResult.ErrorMsg, ErrorParam: batchResult.ErrorParam}
+		}
+		return results, nil
+	}
+
+	for i, result := range batchResult.Data {
+		if i >= len(orderIndex) {
+			break
+		}
+		results[orderIndex[i]] = result
+	}
+
+	return results, nil
+}
+
+// CancelOrders cancels a batch of orders by order ID in a single request.
+func (c *Client) CancelOrders(ctx context.Context, paramsList []*CancelOrderParams) (interface{}, error) {
+	if len(paramsList) == 0 {
+		return nil, fmt.Errorf("paramsList must not be empty")
+	}
+
+	var orderIds, clientIds []string
+	for _, params := range paramsList {
+		switch {
+		case params.OrderId != "":
+			orderIds = append(orderIds, params.OrderId)
+		case params.ClientId != "":
+			clientIds = append(clientIds, params.ClientId)
+		default:
+			return nil, fmt.Errorf("each CancelOrderParams must provide either OrderId or ClientId")
+		}
+	}
+
+	accountID := strconv.FormatInt(c.Client.GetAccountID(), 10)
+	body := map[string]interface{}{
+		"accountId": accountID,
+	}
+	if len(orderIds) > 0 {
+		body["orderIdList"] = orderIds
+	}
+	if len(clientIds) > 0 {
+		body["clientOrderIdList"] = clientIds
+	}
+
+	url := fmt.Sprintf("%s/api/v1/private/order/cancelOrderBatch", c.Client.GetBaseURL())
+	resp, err := c.Client.HttpRequest(url, "POST", body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to cancel order batch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	if code, ok := result["code"].(string); ok && code != "SUCCESS" {
+		return nil, fmt.Errorf("request failed with code: %s", code)
+	}
+
+	return result, nil
+}
+
+// reserveClientOrderIds generates n client order IDs that are pairwise
+// collision-free within the batch, retrying gen on a duplicate. It is
+// factored out of CreateOrders so the dedup loop can be exercised with a
+// small, collision-prone generator in tests instead of depending on gen's
+// real randomness to (almost certainly) never collide.
+func reserveClientOrderIds(n int, gen func() string) []string {
+	seen := make(map[string]struct{}, n)
+	ids := make([]string, n)
+	for i := 0; i < n; i++ {
+		id := gen()
+		for {
+			if _, dup := seen[id]; !dup {
+				break
+			}
+			id = gen()
+		}
+		seen[id] = struct{}{}
+		ids[i] = id
+	}
+	return ids
+}
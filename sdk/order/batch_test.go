@@ -0,0 +1,65 @@
+package order
+
+import "testing"
+
+func TestReserveClientOrderIdsIsCollisionFree(t *testing.T) {
+	// A generator drawing from a tiny pool forces the dedup loop to retry
+	// repeatedly; reserveClientOrderIds must still return n unique ids.
+	pool := []string{"a", "a", "a", "b", "a", "b", "c", "a", "b", "c"}
+	next := 0
+	gen := func() string {
+		id := pool[next%len(pool)]
+		next++
+		return id
+	}
+
+	ids := reserveClientOrderIds(3, gen)
+	if len(ids) != 3 {
+		t.Fatalf("expected 3 ids, got %d", len(ids))
+	}
+
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		if _, dup := seen[id]; dup {
+			t.Fatalf("reserveClientOrderIds returned a duplicate id: %q in %v", id, ids)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestReserveClientOrderIdsPreservesOrderAndCount(t *testing.T) {
+	i := 0
+	gen := func() string {
+		i++
+		return string(rune('a' + i))
+	}
+
+	ids := reserveClientOrderIds(5, gen)
+	if len(ids) != 5 {
+		t.Fatalf("expected 5 ids, got %d", len(ids))
+	}
+	for idx, id := range ids {
+		if id == "" {
+			t.Fatalf("id at index %d is empty", idx)
+		}
+		if want := string(rune('a' + idx + 1)); id != want {
+			t.Fatalf("id at index %d: expected %q (generation order), got %q", idx, want, id)
+		}
+	}
+}
+
+func TestReserveClientOrderIdsZero(t *testing.T) {
+	calls := 0
+	gen := func() string {
+		calls++
+		return "x"
+	}
+
+	ids := reserveClientOrderIds(0, gen)
+	if len(ids) != 0 {
+		t.Fatalf("expected 0 ids, got %d", len(ids))
+	}
+	if calls != 0 {
+		t.Fatalf("expected gen not to be called, got %d calls", calls)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"io"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
 	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
@@ -17,16 +16,57 @@ import (
 // Client represents the new order client without OpenAPI dependencies
 type Client struct {
 	*internal.Client
+
+	nonceStore NonceStore
+
+	// experimentalConditionalOrders gates CreateConditionalOrder and
+	// CreateTpSlForPosition; see EnableExperimentalConditionalOrders.
+	experimentalConditionalOrders bool
 }
 
 // NewClient creates a new order client
 func NewClient(client *internal.Client) *Client {
 	return &Client{
-		Client: client,
+		Client:     client,
+		nonceStore: NewInMemoryNonceStore(),
+	}
+}
+
+// NewClientWithNonceStore creates a new order client backed by a caller-
+// supplied NonceStore, for strategies that need pending submissions to
+// survive a process restart (see BoltNonceStore).
+func NewClientWithNonceStore(client *internal.Client, nonceStore NonceStore) *Client {
+	return &Client{
+		Client:     client,
+		nonceStore: nonceStore,
 	}
 }
 
-// CreateOrder creates a new order with the given parameters
+// EnableExperimentalConditionalOrders opts this client into
+// CreateConditionalOrder and CreateTpSlForPosition. Unlike every other hash
+// this SDK signs, the conditional-order hash (conditionalOrderHasher, i.e.
+// internal.CalcConditionalOrderHash) has not been confirmed against a
+// known-good test vector from edgeX's server-side conditional-order spec;
+// this package only pins its own assumptions about trigger-price scaling and
+// field order (see TestBuildConditionalHashArgs_TriggerPriceScaling), not
+// the exchange's actual expectation. Calling this method is an explicit
+// acknowledgement that you have independently verified that signing, or
+// accept the risk of signing live conditional orders without that
+// verification. CreateConditionalOrder returns
+// ErrConditionalOrderSigningUnverified until this is called.
+func (c *Client) EnableExperimentalConditionalOrders() {
+	c.experimentalConditionalOrders = true
+}
+
+// CreateOrder creates a new order with the given parameters. Price and size
+// are validated locally against the contract's tick size, step size, and
+// min notional before signing (see validateOrderParams); the account's max
+// order size is not checked here because that requires a GetMaxOrderSize
+// round trip, which CreateOrder's callers (including PlaceLiquidityLayers)
+// and CreateOrders, which shares its signing path, cannot all afford to pay
+// per order. Call
+// CheckMaxOrderSize explicitly first if that check matters for your use
+// case.
 func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, metadata *metadatapkg.MetaData, l2Price decimal.Decimal) (*ResultCreateOrder, error) {
 	// Set default TimeInForce based on order type if not specified
 	if params.TimeInForce == "" {
@@ -38,121 +78,33 @@ func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, met
 		}
 	}
 
-	// Find contract from metadata
-	var contract *metadatapkg.Contract
-	if metadata != nil && metadata.ContractList != nil {
-		for i := range metadata.ContractList {
-			if metadata.ContractList[i].ContractId == params.ContractId {
-				contract = &metadata.ContractList[i]
-				break
-			}
-		}
-	}
-
-	if contract == nil {
-		return nil, fmt.Errorf("contract not found: %s", params.ContractId)
-	}
+	// Reuse the caller-supplied ClientOrderId when present so a retried
+	// call after a transport error resubmits the same signed payload
+	// instead of burning a fresh nonce on a new signature.
+	clientOrderId := params.ClientOrderId
 
-	var quoteCoin *metadatapkg.Coin
-	if metadata != nil && metadata.CoinList != nil {
-		for i := range metadata.CoinList {
-			if metadata.CoinList[i].CoinId == contract.QuoteCoinId {
-				quoteCoin = &metadata.CoinList[i]
-				break
-			}
+	var body map[string]interface{}
+	if clientOrderId != "" {
+		if pending, ok, err := c.nonceStore.Get(clientOrderId); err == nil && ok && !pending.Resolved {
+			body = pending.Body
 		}
 	}
 
-	if quoteCoin == nil {
-		return nil, fmt.Errorf("coin not found: %s", contract.QuoteCoinId)
-	}
-
-	syntheticFactorBig, err := internal.HexToBigInteger(contract.StarkExResolution)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse synthetic factor: %w", err)
-	}
-	syntheticFactor := decimal.NewFromBigInt(syntheticFactorBig, 0)
-
-	shiftFactorBig, err := internal.HexToBigInteger(quoteCoin.StarkExResolution)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse shift factor: %w", err)
-	}
-	shiftFactor := decimal.NewFromBigInt(shiftFactorBig, 0)
-	// Parse decimal values
-	size, err := decimal.NewFromString(params.Size)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse size: %w", err)
-	}
-
-	// Calculate values
-	valueDm := l2Price.Mul(size)
-
-	amountSynthetic := size.Mul(syntheticFactor).IntPart()
-	amountCollateral := valueDm.Mul(shiftFactor).IntPart()
-
-	// Get fee rate from contract or use default
-	var feeRate decimal.Decimal
-	if contract.DefaultTakerFeeRate != "" {
-		feeRateVal, err := decimal.NewFromString(contract.DefaultTakerFeeRate)
+	if body == nil {
+		var err error
+		body, clientOrderId, err = c.signAndBuildOrderBody(ctx, params, metadata, l2Price, clientOrderId)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse fee rate: %w", err)
+			return nil, err
 		}
-		feeRate = feeRateVal
-	} else {
-		feeRate, _ = decimal.NewFromString("0.001") // Default fee rate
-	}
-
-	// Calculate fee amount in decimal with ceiling to integer
-	limitFee := size.Mul(l2Price).Mul(feeRate).Ceil()
-	maxAmountFee := limitFee.Mul(shiftFactor)
-
-	clientOrderId := internal.GetRandomClientId()
-
-	nonce := internal.CalcNonce(clientOrderId)
-	l2ExpireTime := params.ExpireTime.Add(time.Hour * 9 * 24).UnixMilli()
-	l2ExpireHour := l2ExpireTime / (60 * 60 * 1000)
-
-	msgHash := internal.CalcLimitOrderHash(
-		contract.StarkExSyntheticAssetId,
-		quoteCoin.StarkExAssetId,
-		quoteCoin.StarkExAssetId,
-		params.Side == "BUY",
-		amountSynthetic,
-		amountCollateral,
-		maxAmountFee.BigInt().Int64(),
-		nonce,
-		c.Client.GetAccountID(),
-		l2ExpireHour,
-	)
-	signature, err := c.Client.Sign(msgHash)
-	if err != nil {
-		return nil, fmt.Errorf("failed to sign withdrawal hash: %w", err)
-	}
-	sig_str := fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V)
-
-	// Build request body
-	body := map[string]interface{}{
-		"accountId":     strconv.FormatInt(c.Client.GetAccountID(), 10),
-		"contractId":    params.ContractId,
-		"price":         params.Price,
-		"size":          params.Size,
-		"type":          string(params.Type),
-		"side":          params.Side,
-		"timeInForce":   params.TimeInForce,
-		"clientOrderId": clientOrderId,
-		"expireTime":    strconv.FormatInt(params.ExpireTime.UnixMilli(), 10),
-		"l2Nonce":       strconv.FormatInt(nonce, 10),
-		"l2Signature":   sig_str,
-		"l2ExpireTime":  strconv.FormatInt(l2ExpireTime, 10),
-		"l2Value":       valueDm.String(),
-		"l2Size":        params.Size,
-		"l2LimitFee":    limitFee.String(),
-		"reduceOnly":    params.ReduceOnly,
 	}
 
 	url := fmt.Sprintf("%s/api/v1/private/order/createOrder", c.Client.GetBaseURL())
 	resp, err := c.Client.HttpRequest(url, "POST", body, nil)
 	if err != nil {
+		// Leave the submission unresolved in the nonce store: the caller
+		// can retry with the same ClientOrderId and resubmit this exact
+		// payload, or call ResumePendingSubmits later to find out whether
+		// the exchange actually received it despite the transport error.
 		return nil, fmt.Errorf("failed to create order: %w", err)
 	}
 	defer resp.Body.Close()
@@ -167,6 +119,11 @@ func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, met
 		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
 	}
 
+	// The exchange has now given a definitive answer for this
+	// clientOrderId (accepted or rejected), so it no longer needs to be
+	// resumed even though the HTTP round-trip succeeded.
+	markResolvedErr := c.nonceStore.MarkResolved(clientOrderId)
+
 	if result.Code != "SUCCESS" {
 		if result.ErrorMsg != "" {
 			return nil, fmt.Errorf("request failed: %s (code: %s, errorParam: %v)", result.ErrorMsg, result.Code, result.ErrorParam)
@@ -174,6 +131,16 @@ func (c *Client) CreateOrder(ctx context.Context, params *CreateOrderParams, met
 		return nil, fmt.Errorf("request failed with code: %s, errorParam: %v", result.Code, result.ErrorParam)
 	}
 
+	if markResolvedErr != nil {
+		// The order was accepted by the exchange; only the local
+		// bookkeeping failed. Return the confirmed result alongside the
+		// error instead of discarding it, so the caller can tell the order
+		// went through even though its pending submission may still show
+		// up as unresolved (e.g. to ResumePendingSubmits) until the store
+		// recovers.
+		return &result, fmt.Errorf("order was accepted but failed to mark submission resolved: %w", markResolvedErr)
+	}
+
 	return &result, nil
 }
 
@@ -0,0 +1,386 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// ErrConditionalOrderSigningUnverified is returned by CreateConditionalOrder
+// (and therefore CreateTpSlForPosition) until the client has called
+// EnableExperimentalConditionalOrders. See that method's doc comment.
+var ErrConditionalOrderSigningUnverified = errors.New("edgex: conditional order signing is experimental and unverified against edgeX's spec; call Client.EnableExperimentalConditionalOrders to opt in")
+
+// conditionalOrderHasher computes the StarkEx signable hash for a
+// conditional order, including the trigger price in the signed payload so
+// the trigger itself (not just the resting limit order it arms) is covered
+// by the L2 signature. Its arguments are assembled by
+// buildConditionalHashArgs, whose trigger-price scaling and field order are
+// pinned by TestBuildConditionalHashArgs_TriggerPriceScaling.
+//
+// EXPERIMENTAL: this hash variant has not been confirmed against a
+// known-good test vector from edgeX's server; see
+// ErrConditionalOrderSigningUnverified and EnableExperimentalConditionalOrders.
+var conditionalOrderHasher = internal.CalcConditionalOrderHash
+
+// TriggerPriceType selects which price feed a conditional order's trigger is
+// evaluated against.
+type TriggerPriceType string
+
+const (
+	TriggerPriceTypeLast  TriggerPriceType = "LAST"
+	TriggerPriceTypeMark  TriggerPriceType = "MARK"
+	TriggerPriceTypeIndex TriggerPriceType = "INDEX"
+)
+
+// CreateConditionalOrderParams describes a trigger (stop or take-profit /
+// stop-loss) order. It embeds the same fields as CreateOrderParams plus the
+// trigger configuration; Price is the limit price used once the order
+// triggers, and TriggerPrice is the level that arms it.
+type CreateConditionalOrderParams struct {
+	ContractId  string
+	Price       string
+	Size        string
+	Type        OrderType
+	Side        string
+	TimeInForce string
+	ExpireTime  time.Time
+	ReduceOnly  bool
+
+	// ClientOrderId, when set, is reused on retry so a transport error
+	// after signing resubmits the exact same payload instead of burning a
+	// fresh nonce (mirrors CreateOrderParams.ClientOrderId). Left empty,
+	// one is generated.
+	ClientOrderId string
+
+	TriggerPrice     string
+	TriggerPriceType TriggerPriceType
+	IsPositionTpsl   bool
+}
+
+// conditionalHashArgs bundles the arguments conditionalOrderHasher is called
+// with, in order, so the trigger-price scaling that produces them can be
+// pinned by a test independent of signing or network concerns.
+type conditionalHashArgs struct {
+	syntheticAssetId      string
+	collateralAssetIdSell string
+	collateralAssetIdBuy  string
+	isBuy                 bool
+	amountSynthetic       int64
+	amountCollateral      int64
+	maxAmountFee          int64
+	triggerPriceAmount    int64
+	nonce                 int64
+	accountId             int64
+	l2ExpireHour          int64
+}
+
+// buildConditionalHashArgs scales triggerPriceStr into StarkEx's fixed-point
+// representation using quoteCoin's resolution (the same shift factor
+// prepareOrderSigningInputs already applied to price and size) and
+// assembles the rest of conditionalOrderHasher's arguments from inputs.
+func buildConditionalHashArgs(inputs *orderSigningInputs, side, triggerPriceStr string, nonce, accountId, l2ExpireHour int64) (*conditionalHashArgs, error) {
+	triggerPrice, err := decimal.NewFromString(triggerPriceStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse triggerPrice: %w", err)
+	}
+	shiftFactorBig, err := internal.HexToBigInteger(inputs.quoteCoin.StarkExResolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse shift factor: %w", err)
+	}
+	triggerPriceAmount := triggerPrice.Mul(decimal.NewFromBigInt(shiftFactorBig, 0)).IntPart()
+
+	return &conditionalHashArgs{
+		syntheticAssetId:      inputs.contract.StarkExSyntheticAssetId,
+		collateralAssetIdSell: inputs.quoteCoin.StarkExAssetId,
+		collateralAssetIdBuy:  inputs.quoteCoin.StarkExAssetId,
+		isBuy:                 side == "BUY",
+		amountSynthetic:       inputs.amountSynthetic,
+		amountCollateral:      inputs.amountCollateral,
+		maxAmountFee:          inputs.maxAmountFee.BigInt().Int64(),
+		triggerPriceAmount:    triggerPriceAmount,
+		nonce:                 nonce,
+		accountId:             accountId,
+		l2ExpireHour:          l2ExpireHour,
+	}, nil
+}
+
+// signConditionalOrder builds the StarkEx signable payload for a conditional
+// order. It shares prepareOrderSigningInputs and validateOrderParams with
+// the plain order path for the contract/coin/fee arithmetic and the
+// tick/step/min-notional checks, then signs a hash that additionally covers
+// the trigger price: unlike a plain limit order, a conditional order is not
+// resting on the book for the trader to review before it fills, so the
+// trigger that arms it needs to be part of what the signature attests to,
+// not just metadata carried alongside it. Like signAndBuildOrderBody, it
+// records the submission in the NonceStore before returning so a transport
+// error after signing can be retried instead of burning the nonce.
+func (c *Client) signConditionalOrder(params *CreateConditionalOrderParams, metadata *metadatapkg.MetaData, l2Price decimal.Decimal, clientOrderId string) (map[string]interface{}, string, error) {
+	inputs, err := prepareOrderSigningInputs(metadata, params.ContractId, params.Size, l2Price)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if validationErr := validateOrderParams(inputs.contract, l2Price, inputs.size); validationErr != nil {
+		return nil, "", validationErr
+	}
+
+	if clientOrderId == "" {
+		clientOrderId = internal.GetRandomClientId()
+	}
+	nonce := internal.CalcNonce(clientOrderId)
+	l2ExpireTime := params.ExpireTime.Add(time.Hour * 9 * 24).UnixMilli()
+	l2ExpireHour := l2ExpireTime / (60 * 60 * 1000)
+
+	args, err := buildConditionalHashArgs(inputs, params.Side, params.TriggerPrice, nonce, c.Client.GetAccountID(), l2ExpireHour)
+	if err != nil {
+		return nil, "", err
+	}
+
+	msgHash := conditionalOrderHasher(
+		args.syntheticAssetId,
+		args.collateralAssetIdSell,
+		args.collateralAssetIdBuy,
+		args.isBuy,
+		args.amountSynthetic,
+		args.amountCollateral,
+		args.maxAmountFee,
+		args.triggerPriceAmount,
+		args.nonce,
+		args.accountId,
+		args.l2ExpireHour,
+	)
+	signature, err := c.Client.Sign(msgHash)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign conditional order hash: %w", err)
+	}
+	sigStr := fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V)
+
+	body := map[string]interface{}{
+		"accountId":        strconv.FormatInt(c.Client.GetAccountID(), 10),
+		"contractId":       params.ContractId,
+		"price":            params.Price,
+		"size":             params.Size,
+		"type":             string(params.Type),
+		"side":             params.Side,
+		"timeInForce":      params.TimeInForce,
+		"clientOrderId":    clientOrderId,
+		"expireTime":       strconv.FormatInt(params.ExpireTime.UnixMilli(), 10),
+		"l2Nonce":          strconv.FormatInt(nonce, 10),
+		"l2Signature":      sigStr,
+		"l2ExpireTime":     strconv.FormatInt(l2ExpireTime, 10),
+		"l2Value":          inputs.valueDm.String(),
+		"l2Size":           params.Size,
+		"l2LimitFee":       inputs.limitFee.String(),
+		"reduceOnly":       params.ReduceOnly,
+		"triggerPrice":     params.TriggerPrice,
+		"triggerPriceType": string(params.TriggerPriceType),
+		"isPositionTpsl":   params.IsPositionTpsl,
+	}
+
+	if err := c.nonceStore.Save(&PendingSubmit{
+		ClientOrderId: clientOrderId,
+		Nonce:         nonce,
+		L2ExpireTime:  l2ExpireTime,
+		MsgHash:       fmt.Sprintf("%v", msgHash),
+		Signature:     sigStr,
+		Body:          body,
+	}); err != nil {
+		return nil, "", fmt.Errorf("failed to persist pending submit: %w", err)
+	}
+
+	return body, clientOrderId, nil
+}
+
+// CreateConditionalOrder creates a trigger order: a stop or a take-profit /
+// stop-loss leg that only becomes a live limit order once TriggerPrice is
+// crossed on the feed selected by TriggerPriceType. Like CreateOrder, a
+// caller-supplied ClientOrderId with an unresolved pending submission is
+// resubmitted verbatim rather than re-signed with a fresh nonce.
+//
+// EXPERIMENTAL: returns ErrConditionalOrderSigningUnverified unless the
+// client has called EnableExperimentalConditionalOrders, because
+// conditionalOrderHasher's output has not been confirmed against edgeX's
+// server-side conditional-order spec.
+func (c *Client) CreateConditionalOrder(ctx context.Context, params *CreateConditionalOrderParams, metadata *metadatapkg.MetaData, l2Price decimal.Decimal) (*ResultCreateOrder, error) {
+	if !c.experimentalConditionalOrders {
+		return nil, ErrConditionalOrderSigningUnverified
+	}
+	if params.TriggerPrice == "" {
+		return nil, fmt.Errorf("triggerPrice is required for a conditional order")
+	}
+	if params.TriggerPriceType == "" {
+		params.TriggerPriceType = TriggerPriceTypeLast
+	}
+	if params.TimeInForce == "" {
+		params.TimeInForce = string(TimeInForce_GOOD_TIL_CANCEL)
+	}
+
+	clientOrderId := params.ClientOrderId
+
+	var body map[string]interface{}
+	if clientOrderId != "" {
+		if pending, ok, err := c.nonceStore.Get(clientOrderId); err == nil && ok && !pending.Resolved {
+			body = pending.Body
+		}
+	}
+
+	if body == nil {
+		var err error
+		body, clientOrderId, err = c.signConditionalOrder(params, metadata, l2Price, clientOrderId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	url := fmt.Sprintf("%s/api/v1/private/order/createOrder", c.Client.GetBaseURL())
+	resp, err := c.Client.HttpRequest(url, "POST", body, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create conditional order: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result ResultCreateOrder
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	markResolvedErr := c.nonceStore.MarkResolved(clientOrderId)
+
+	if result.Code != "SUCCESS" {
+		if result.ErrorMsg != "" {
+			return nil, fmt.Errorf("request failed: %s (code: %s, errorParam: %v)", result.ErrorMsg, result.Code, result.ErrorParam)
+		}
+		return nil, fmt.Errorf("request failed with code: %s, errorParam: %v", result.Code, result.ErrorParam)
+	}
+
+	if markResolvedErr != nil {
+		// See CreateOrder: the conditional order was accepted, only the
+		// local bookkeeping failed, so return the confirmed result instead
+		// of discarding it.
+		return &result, fmt.Errorf("order was accepted but failed to mark submission resolved: %w", markResolvedErr)
+	}
+
+	return &result, nil
+}
+
+// TpSlResult pairs the take-profit and stop-loss legs issued by
+// CreateTpSlForPosition so callers can see which leg, if any, failed.
+type TpSlResult struct {
+	TakeProfit *ResultCreateOrder
+	StopLoss   *ResultCreateOrder
+}
+
+// CreateTpSlForPosition reads the account's current position for contractId
+// out of metadata and issues the paired take-profit / stop-loss conditional
+// orders needed to close it: a sell-side trigger above the entry for TP and
+// a sell-side trigger below it for SL on a long position, mirrored for a
+// short. Either tp or sl may be the zero value to skip that leg.
+//
+// The legs are placed sequentially, not atomically: if the SL leg fails
+// after the TP leg was already accepted, this makes a best-effort attempt
+// to cancel the TP leg rather than return it dangling and unpaired. That
+// cancel attempt can itself fail (e.g. the TP already filled), in which
+// case the error wraps the stop-loss failure, also reports the cancel
+// failure in its message, and the caller must check result.TakeProfit.
+//
+// EXPERIMENTAL: each leg is placed via CreateConditionalOrder, so this
+// returns ErrConditionalOrderSigningUnverified unless the client has called
+// EnableExperimentalConditionalOrders.
+func (c *Client) CreateTpSlForPosition(ctx context.Context, contractId string, tp, sl decimal.Decimal, metadata *metadatapkg.MetaData) (*TpSlResult, error) {
+	position, err := findPosition(metadata, contractId)
+	if err != nil {
+		return nil, err
+	}
+
+	size, err := decimal.NewFromString(position.OpenSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse position size: %w", err)
+	}
+	if size.IsZero() {
+		return nil, fmt.Errorf("no open position for contract: %s", contractId)
+	}
+
+	closeSide := "SELL"
+	if size.IsNegative() {
+		closeSide = "BUY"
+		size = size.Neg()
+	}
+
+	expireTime := time.Now().Add(30 * 24 * time.Hour)
+	result := &TpSlResult{}
+
+	if !tp.IsZero() {
+		order, err := c.CreateConditionalOrder(ctx, &CreateConditionalOrderParams{
+			ContractId:       contractId,
+			Price:            tp.String(),
+			Size:             size.String(),
+			Type:             OrderTypeLimit,
+			Side:             closeSide,
+			ExpireTime:       expireTime,
+			ReduceOnly:       true,
+			TriggerPrice:     tp.String(),
+			TriggerPriceType: TriggerPriceTypeLast,
+			IsPositionTpsl:   true,
+		}, metadata, tp)
+		// order can be non-nil alongside a non-nil err (the leg was
+		// accepted but CreateConditionalOrder's nonce-store bookkeeping
+		// failed afterward); keep it rather than dropping a leg that is
+		// actually live on the exchange.
+		result.TakeProfit = order
+		if err != nil {
+			return result, fmt.Errorf("failed to create take-profit leg: %w", err)
+		}
+	}
+
+	if !sl.IsZero() {
+		order, err := c.CreateConditionalOrder(ctx, &CreateConditionalOrderParams{
+			ContractId:       contractId,
+			Price:            sl.String(),
+			Size:             size.String(),
+			Type:             OrderTypeLimit,
+			Side:             closeSide,
+			ExpireTime:       expireTime,
+			ReduceOnly:       true,
+			TriggerPrice:     sl.String(),
+			TriggerPriceType: TriggerPriceTypeLast,
+			IsPositionTpsl:   true,
+		}, metadata, sl)
+		result.StopLoss = order
+		if err != nil {
+			if result.TakeProfit != nil {
+				if _, cancelErr := c.CancelOrder(ctx, &CancelOrderParams{OrderId: result.TakeProfit.OrderId}); cancelErr != nil {
+					return result, fmt.Errorf("failed to create stop-loss leg: %w; rollback of take-profit leg %s also failed: %v", err, result.TakeProfit.OrderId, cancelErr)
+				}
+				result.TakeProfit = nil
+			}
+			return result, fmt.Errorf("failed to create stop-loss leg: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func findPosition(metadata *metadatapkg.MetaData, contractId string) (*metadatapkg.Position, error) {
+	if metadata != nil && metadata.PositionList != nil {
+		for i := range metadata.PositionList {
+			if metadata.PositionList[i].ContractId == contractId {
+				return &metadata.PositionList[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("position not found: %s", contractId)
+}
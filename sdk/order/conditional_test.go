@@ -0,0 +1,100 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+func TestCreateConditionalOrderRequiresExperimentalOptIn(t *testing.T) {
+	c := &Client{}
+
+	_, err := c.CreateConditionalOrder(context.Background(), &CreateConditionalOrderParams{
+		ContractId:   "BTC-USD",
+		TriggerPrice: "50000",
+	}, nil, decimal.NewFromInt(50000))
+	if !errors.Is(err, ErrConditionalOrderSigningUnverified) {
+		t.Fatalf("expected ErrConditionalOrderSigningUnverified, got %v", err)
+	}
+
+	c.EnableExperimentalConditionalOrders()
+	if !c.experimentalConditionalOrders {
+		t.Fatalf("expected EnableExperimentalConditionalOrders to set the opt-in flag")
+	}
+}
+
+// fixtureSigningInputs returns orderSigningInputs with a fixed quote coin
+// resolution, so buildConditionalHashArgs' trigger-price scaling can be
+// pinned against a hand-computed value below.
+func fixtureSigningInputs() *orderSigningInputs {
+	return &orderSigningInputs{
+		contract:         &metadatapkg.Contract{StarkExSyntheticAssetId: "SYNTHETIC_ASSET"},
+		quoteCoin:        &metadatapkg.Coin{StarkExAssetId: "COLLATERAL_ASSET", StarkExResolution: "0x2710"}, // 10000
+		amountSynthetic:  123,
+		amountCollateral: 456,
+		maxAmountFee:     decimal.NewFromInt(10),
+	}
+}
+
+func TestBuildConditionalHashArgs_TriggerPriceScaling(t *testing.T) {
+	inputs := fixtureSigningInputs()
+
+	args, err := buildConditionalHashArgs(inputs, "BUY", "100.5", 42, 7, 100)
+	if err != nil {
+		t.Fatalf("buildConditionalHashArgs returned error: %v", err)
+	}
+
+	// 100.5 * 10000 (the fixture's StarkExResolution) == 1_005_000.
+	if args.triggerPriceAmount != 1_005_000 {
+		t.Fatalf("expected triggerPriceAmount 1005000, got %d", args.triggerPriceAmount)
+	}
+	if !args.isBuy {
+		t.Fatalf("expected isBuy true for side BUY")
+	}
+	if args.syntheticAssetId != "SYNTHETIC_ASSET" || args.collateralAssetIdSell != "COLLATERAL_ASSET" || args.collateralAssetIdBuy != "COLLATERAL_ASSET" {
+		t.Fatalf("unexpected asset ids: %+v", args)
+	}
+	if args.amountSynthetic != 123 || args.amountCollateral != 456 || args.maxAmountFee != 10 {
+		t.Fatalf("amounts should pass through from orderSigningInputs unchanged, got %+v", args)
+	}
+	if args.nonce != 42 || args.accountId != 7 || args.l2ExpireHour != 100 {
+		t.Fatalf("nonce/accountId/l2ExpireHour should pass through unchanged, got %+v", args)
+	}
+}
+
+func TestBuildConditionalHashArgs_SellSide(t *testing.T) {
+	args, err := buildConditionalHashArgs(fixtureSigningInputs(), "SELL", "50", 1, 1, 1)
+	if err != nil {
+		t.Fatalf("buildConditionalHashArgs returned error: %v", err)
+	}
+	if args.isBuy {
+		t.Fatalf("expected isBuy false for side SELL")
+	}
+	if args.triggerPriceAmount != 500_000 {
+		t.Fatalf("expected triggerPriceAmount 500000, got %d", args.triggerPriceAmount)
+	}
+}
+
+func TestBuildConditionalHashArgs_RejectsUnparseableTriggerPrice(t *testing.T) {
+	if _, err := buildConditionalHashArgs(fixtureSigningInputs(), "BUY", "not-a-price", 1, 1, 1); err == nil {
+		t.Fatalf("expected an error for an unparseable trigger price")
+	}
+}
+
+func TestValidateOrderParamsAppliesToConditionalOrders(t *testing.T) {
+	// signConditionalOrder validates against the same tick size a plain
+	// limit order would, so an off-tick limit price is rejected before
+	// signing rather than only being caught server-side. The trigger price
+	// itself is not validated.
+	contract := &metadatapkg.Contract{TickSize: "0.5"}
+	err := validateOrderParams(contract, decimal.NewFromFloat(100.3), decimal.NewFromInt(1))
+	if err == nil {
+		t.Fatalf("expected a validation error for an off-tick conditional order price")
+	}
+	if _, ok := err.(*OrderValidationError); !ok {
+		t.Fatalf("expected *OrderValidationError, got %T", err)
+	}
+}
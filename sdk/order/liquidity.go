@@ -0,0 +1,237 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// LayerScale selects how per-layer quantity weights are distributed across the
+// ladder. Weights are computed over the domain [1, NumLayers] and mapped onto
+// [MinWeight, MaxWeight] before being normalized so they sum to the total
+// liquidity amount for that side.
+type LayerScale string
+
+const (
+	// LayerScaleLinear interpolates weights linearly from MinWeight at the innermost layer to MaxWeight at the outermost.
+	LayerScaleLinear LayerScale = "LINEAR"
+	// LayerScaleExponential weights layers using exponential decay/growth across the ladder.
+	LayerScaleExponential LayerScale = "EXPONENTIAL"
+)
+
+// PlaceLiquidityLayersParams configures a symmetric ladder of bid and ask
+// limit orders placed around a reference price.
+type PlaceLiquidityLayersParams struct {
+	ContractId string
+	NumLayers  int
+
+	BidLiquidityAmount decimal.Decimal
+	AskLiquidityAmount decimal.Decimal
+
+	// PriceRange is the percentage distance from the reference price spanned
+	// by the outermost layer on each side, e.g. 0.01 for 1%.
+	PriceRange decimal.Decimal
+
+	// Scale controls how the total liquidity amount is split across layers.
+	Scale     LayerScale
+	MinWeight decimal.Decimal
+	MaxWeight decimal.Decimal
+
+	// ReferencePrice is the price the ladder is centered on. Leave it zero
+	// to have PlaceLiquidityLayers resolve it via ReferencePriceFunc instead
+	// (e.g. "use last trade price"); at least one of the two must be set.
+	ReferencePrice decimal.Decimal
+
+	// ReferencePriceFunc is called once to resolve ReferencePrice when it is
+	// left zero, e.g. wired to a ticker or last-trade-price lookup. It is
+	// not called when ReferencePrice is already set.
+	ReferencePriceFunc func(ctx context.Context) (decimal.Decimal, error)
+
+	TimeInForce string
+
+	// CheckMaxOrderSize, when true, calls Client.CheckMaxOrderSize for each
+	// layer before submitting it. It is opt-in because it costs one
+	// GetMaxOrderSize round trip per layer on top of the 2*NumLayers orders
+	// already being placed; leave it false if that cost is not acceptable
+	// for your ladder size.
+	CheckMaxOrderSize bool
+}
+
+// LiquidityLayerResult reports the outcome of placing a single layer's order
+// so callers can reconcile partial failures across the ladder.
+type LiquidityLayerResult struct {
+	Layer int
+	Side  string
+	Price decimal.Decimal
+	Size  decimal.Decimal
+	Order *ResultCreateOrder
+	Err   error
+}
+
+// PlaceLiquidityLayers generates and submits NumLayers buy orders and
+// NumLayers sell orders around a reference price in a single call. Each
+// layer's price is snapped to the contract's tick size and its size is a
+// share of BidLiquidityAmount/AskLiquidityAmount determined by Scale. Orders
+// are signed and submitted concurrently; a failure on one layer does not
+// prevent the others from being placed.
+func (c *Client) PlaceLiquidityLayers(ctx context.Context, params *PlaceLiquidityLayersParams, metadata *metadatapkg.MetaData) ([]LiquidityLayerResult, error) {
+	if params.NumLayers <= 0 {
+		return nil, fmt.Errorf("numLayers must be positive")
+	}
+	if params.ReferencePrice.IsZero() && params.ReferencePriceFunc == nil {
+		return nil, fmt.Errorf("referencePrice is required (or set ReferencePriceFunc)")
+	}
+
+	contract, err := findContract(metadata, params.ContractId)
+	if err != nil {
+		return nil, err
+	}
+
+	refPrice := params.ReferencePrice
+	if refPrice.IsZero() {
+		refPrice, err = params.ReferencePriceFunc(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve reference price: %w", err)
+		}
+		if refPrice.IsZero() {
+			return nil, fmt.Errorf("referencePriceFunc returned a zero price")
+		}
+	}
+
+	weights, err := layerWeights(params.NumLayers, params.Scale, params.MinWeight, params.MaxWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		results []LiquidityLayerResult
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+
+	submit := func(layer int, side string, price, size decimal.Decimal) {
+		defer wg.Done()
+		res := LiquidityLayerResult{Layer: layer, Side: side, Price: price, Size: size}
+
+		if params.CheckMaxOrderSize {
+			if err := c.CheckMaxOrderSize(ctx, contract, price, size); err != nil {
+				res.Err = err
+				mu.Lock()
+				results = append(results, res)
+				mu.Unlock()
+				return
+			}
+		}
+
+		order, err := c.CreateOrder(ctx, &CreateOrderParams{
+			ContractId:  params.ContractId,
+			Price:       price.String(),
+			Size:        size.String(),
+			Type:        OrderTypeLimit,
+			Side:        side,
+			TimeInForce: params.TimeInForce,
+		}, metadata, price)
+		res.Order = order
+		res.Err = err
+
+		mu.Lock()
+		results = append(results, res)
+		mu.Unlock()
+	}
+
+	for i := 0; i < params.NumLayers; i++ {
+		step := params.PriceRange.Div(decimal.NewFromInt(int64(params.NumLayers))).Mul(decimal.NewFromInt(int64(i + 1)))
+
+		bidPrice := roundToTick(contract, refPrice.Mul(decimal.NewFromInt(1).Sub(step)))
+		bidSize := roundToStep(contract, params.BidLiquidityAmount.Mul(weights[i]))
+
+		askPrice := roundToTick(contract, refPrice.Mul(decimal.NewFromInt(1).Add(step)))
+		askSize := roundToStep(contract, params.AskLiquidityAmount.Mul(weights[i]))
+
+		wg.Add(2)
+		go submit(i+1, "BUY", bidPrice, bidSize)
+		go submit(i+1, "SELL", askPrice, askSize)
+	}
+
+	wg.Wait()
+
+	return results, nil
+}
+
+// layerWeights computes per-layer weights over the domain [1, numLayers]
+// mapped onto [minWeight, maxWeight], normalized so they sum to 1.
+func layerWeights(numLayers int, scale LayerScale, minWeight, maxWeight decimal.Decimal) ([]decimal.Decimal, error) {
+	if scale == LayerScaleExponential && (!minWeight.IsPositive() || !maxWeight.IsPositive()) {
+		return nil, fmt.Errorf("minWeight and maxWeight must both be positive for exponential scaling")
+	}
+
+	raw := make([]decimal.Decimal, numLayers)
+	total := decimal.Zero
+
+	for i := 0; i < numLayers; i++ {
+		t := decimal.Zero
+		if numLayers > 1 {
+			t = decimal.NewFromInt(int64(i)).Div(decimal.NewFromInt(int64(numLayers - 1)))
+		}
+
+		var w decimal.Decimal
+		switch scale {
+		case LayerScaleExponential:
+			w = minWeight.Mul(powDecimal(maxWeight.Div(minWeight), t))
+		default:
+			w = minWeight.Add(maxWeight.Sub(minWeight).Mul(t))
+		}
+
+		raw[i] = w
+		total = total.Add(w)
+	}
+
+	if !total.IsPositive() {
+		return nil, fmt.Errorf("minWeight and maxWeight must not both be zero")
+	}
+
+	weights := make([]decimal.Decimal, numLayers)
+	for i, w := range raw {
+		weights[i] = w.Div(total)
+	}
+	return weights, nil
+}
+
+// powDecimal raises base to the given exponent using float64 math; weight
+// curves don't need StarkEx-grade precision.
+func powDecimal(base, exponent decimal.Decimal) decimal.Decimal {
+	b, _ := base.Float64()
+	e, _ := exponent.Float64()
+	return decimal.NewFromFloat(math.Pow(b, e))
+}
+
+func findContract(metadata *metadatapkg.MetaData, contractId string) (*metadatapkg.Contract, error) {
+	if metadata != nil && metadata.ContractList != nil {
+		for i := range metadata.ContractList {
+			if metadata.ContractList[i].ContractId == contractId {
+				return &metadata.ContractList[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("contract not found: %s", contractId)
+}
+
+func roundToTick(contract *metadatapkg.Contract, price decimal.Decimal) decimal.Decimal {
+	tickSize, err := decimal.NewFromString(contract.TickSize)
+	if err != nil || tickSize.IsZero() {
+		return price
+	}
+	return price.DivRound(tickSize, 0).Mul(tickSize)
+}
+
+func roundToStep(contract *metadatapkg.Contract, size decimal.Decimal) decimal.Decimal {
+	stepSize, err := decimal.NewFromString(contract.StepSize)
+	if err != nil || stepSize.IsZero() {
+		return size
+	}
+	return size.DivRound(stepSize, 0).Mul(stepSize)
+}
@@ -0,0 +1,105 @@
+package order
+
+import (
+	"testing"
+
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+func TestLayerWeightsLinearSumsToOne(t *testing.T) {
+	weights, err := layerWeights(5, LayerScaleLinear, decimal.NewFromInt(1), decimal.NewFromInt(3))
+	if err != nil {
+		t.Fatalf("layerWeights returned error: %v", err)
+	}
+	if len(weights) != 5 {
+		t.Fatalf("expected 5 weights, got %d", len(weights))
+	}
+
+	total := decimal.Zero
+	for _, w := range weights {
+		total = total.Add(w)
+	}
+	if !total.Sub(decimal.NewFromInt(1)).Abs().LessThan(decimal.NewFromFloat(1e-9)) {
+		t.Fatalf("weights should sum to 1, got %s", total.String())
+	}
+	if !weights[0].LessThan(weights[len(weights)-1]) {
+		t.Fatalf("linear scale should increase from first to last layer: %v", weights)
+	}
+}
+
+func TestLayerWeightsExponentialSumsToOne(t *testing.T) {
+	weights, err := layerWeights(4, LayerScaleExponential, decimal.NewFromFloat(0.5), decimal.NewFromInt(2))
+	if err != nil {
+		t.Fatalf("layerWeights returned error: %v", err)
+	}
+
+	total := decimal.Zero
+	for _, w := range weights {
+		total = total.Add(w)
+	}
+	if !total.Sub(decimal.NewFromInt(1)).Abs().LessThan(decimal.NewFromFloat(1e-9)) {
+		t.Fatalf("weights should sum to 1, got %s", total.String())
+	}
+}
+
+func TestLayerWeightsExponentialRejectsNonPositiveWeights(t *testing.T) {
+	cases := []struct {
+		name                 string
+		minWeight, maxWeight decimal.Decimal
+	}{
+		{"zero min", decimal.Zero, decimal.NewFromInt(2)},
+		{"zero max", decimal.NewFromInt(1), decimal.Zero},
+		{"negative min", decimal.NewFromInt(-1), decimal.NewFromInt(2)},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := layerWeights(3, LayerScaleExponential, tc.minWeight, tc.maxWeight); err == nil {
+				t.Fatalf("expected error for non-positive weight bounds")
+			}
+		})
+	}
+}
+
+func TestLayerWeightsRejectsZeroDivisor(t *testing.T) {
+	// Linear scaling with min == max == 0 would otherwise divide the
+	// normalization step by a zero total; make sure it's rejected instead
+	// of panicking or returning NaN/Inf weights.
+	if _, err := layerWeights(3, LayerScaleLinear, decimal.Zero, decimal.Zero); err == nil {
+		t.Fatalf("expected error when minWeight and maxWeight are both zero")
+	}
+}
+
+func TestLayerWeightsSingleLayer(t *testing.T) {
+	weights, err := layerWeights(1, LayerScaleLinear, decimal.NewFromInt(1), decimal.NewFromInt(5))
+	if err != nil {
+		t.Fatalf("layerWeights returned error: %v", err)
+	}
+	if len(weights) != 1 || !weights[0].Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("a single layer should take the full weight, got %v", weights)
+	}
+}
+
+func TestRoundToTick(t *testing.T) {
+	contract := &metadatapkg.Contract{TickSize: "0.5"}
+
+	got := roundToTick(contract, decimal.NewFromFloat(10.3))
+	if !got.Equal(decimal.NewFromFloat(10.5)) {
+		t.Fatalf("expected 10.5, got %s", got.String())
+	}
+
+	noTick := &metadatapkg.Contract{TickSize: ""}
+	got = roundToTick(noTick, decimal.NewFromFloat(10.3))
+	if !got.Equal(decimal.NewFromFloat(10.3)) {
+		t.Fatalf("expected unchanged price with no tick size, got %s", got.String())
+	}
+}
+
+func TestRoundToStep(t *testing.T) {
+	contract := &metadatapkg.Contract{StepSize: "0.01"}
+
+	got := roundToStep(contract, decimal.NewFromFloat(1.2345))
+	if !got.Equal(decimal.NewFromFloat(1.23)) {
+		t.Fatalf("expected 1.23, got %s", got.String())
+	}
+}
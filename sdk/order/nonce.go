@@ -0,0 +1,99 @@
+package order
+
+import (
+	"sync"
+)
+
+// PendingSubmit records everything needed to retry or reconcile a single
+// order submission without re-signing: the signed StarkEx payload is fixed
+// the moment the nonce is reserved, so a transport error can be retried
+// verbatim instead of burning a fresh nonce on a new signature.
+type PendingSubmit struct {
+	ClientOrderId string
+	Nonce         int64
+	L2ExpireTime  int64
+	MsgHash       string
+	Signature     string
+	// Body is the exact request body that was (or is about to be) POSTed,
+	// so a retry resubmits byte-for-byte the same signed order.
+	Body map[string]interface{}
+	// Resolved is true once the caller has confirmed, via the exchange's
+	// response or a reconciliation query, whether this submission was
+	// accepted.
+	Resolved bool
+}
+
+// NonceStore persists in-flight order submissions so that a crash or
+// network blip between signing and a confirmed response can be resumed
+// instead of silently orphaning the reserved nonce. Implementations must be
+// safe for concurrent use.
+type NonceStore interface {
+	// Save records a submission before its HTTP POST is attempted.
+	Save(submit *PendingSubmit) error
+	// MarkResolved records that clientOrderId's outcome (accepted or
+	// rejected) is now known and no longer needs to be resumed.
+	MarkResolved(clientOrderId string) error
+	// Pending returns all submissions that have not yet been marked
+	// resolved, for ResumePendingSubmits to reconcile.
+	Pending() ([]*PendingSubmit, error)
+	// Get returns the recorded submission for clientOrderId, if any.
+	Get(clientOrderId string) (*PendingSubmit, bool, error)
+}
+
+// InMemoryNonceStore is the default NonceStore. It does not survive a
+// process restart; callers that need crash recovery across restarts should
+// supply a persistent implementation (for example one backed by bolt or
+// sqlite) to NewClientWithNonceStore.
+type InMemoryNonceStore struct {
+	mu      sync.Mutex
+	submits map[string]*PendingSubmit
+}
+
+// NewInMemoryNonceStore creates an empty InMemoryNonceStore.
+func NewInMemoryNonceStore() *InMemoryNonceStore {
+	return &InMemoryNonceStore{
+		submits: make(map[string]*PendingSubmit),
+	}
+}
+
+func (s *InMemoryNonceStore) Save(submit *PendingSubmit) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submits[submit.ClientOrderId] = submit
+	return nil
+}
+
+func (s *InMemoryNonceStore) MarkResolved(clientOrderId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if submit, ok := s.submits[clientOrderId]; ok {
+		submit.Resolved = true
+	}
+	return nil
+}
+
+func (s *InMemoryNonceStore) Pending() ([]*PendingSubmit, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pending := make([]*PendingSubmit, 0, len(s.submits))
+	for _, submit := range s.submits {
+		if !submit.Resolved {
+			pending = append(pending, submit)
+		}
+	}
+	return pending, nil
+}
+
+func (s *InMemoryNonceStore) Get(clientOrderId string) (*PendingSubmit, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	submit, ok := s.submits[clientOrderId]
+	if !ok {
+		return nil, false, nil
+	}
+	// Return a copy: the stored *PendingSubmit can be mutated by a
+	// concurrent MarkResolved under s.mu after this call returns, and
+	// callers read Resolved/Body outside the lock.
+	copied := *submit
+	return &copied, true, nil
+}
@@ -0,0 +1,102 @@
+package order
+
+import (
+	"encoding/json"
+	"fmt"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// pendingSubmitsBucket is the single bolt bucket BoltNonceStore keeps all
+// pending submissions in, keyed by clientOrderId.
+var pendingSubmitsBucket = []byte("pending_submits")
+
+// BoltNonceStore is an example persistent NonceStore backed by a bolt
+// database file, so pending submissions survive a process restart and
+// ResumePendingSubmits can reconcile them after a crash. It is provided as
+// a reference implementation for callers who need crash recovery; the
+// in-memory default (InMemoryNonceStore) is sufficient for most strategies
+// that only need retry-safety within a single process lifetime.
+type BoltNonceStore struct {
+	db *bolt.DB
+}
+
+// NewBoltNonceStore opens (creating if necessary) a bolt database at path
+// and prepares it for use as a NonceStore.
+func NewBoltNonceStore(path string) (*BoltNonceStore, error) {
+	db, err := bolt.Open(path, 0600, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(pendingSubmitsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create pending submits bucket: %w", err)
+	}
+
+	return &BoltNonceStore{db: db}, nil
+}
+
+// Close releases the underlying bolt database file.
+func (s *BoltNonceStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BoltNonceStore) Save(submit *PendingSubmit) error {
+	data, err := json.Marshal(submit)
+	if err != nil {
+		return fmt.Errorf("failed to marshal pending submit: %w", err)
+	}
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(pendingSubmitsBucket).Put([]byte(submit.ClientOrderId), data)
+	})
+}
+
+func (s *BoltNonceStore) MarkResolved(clientOrderId string) error {
+	submit, ok, err := s.Get(clientOrderId)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	submit.Resolved = true
+	return s.Save(submit)
+}
+
+func (s *BoltNonceStore) Pending() ([]*PendingSubmit, error) {
+	var pending []*PendingSubmit
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(pendingSubmitsBucket).ForEach(func(_, data []byte) error {
+			var submit PendingSubmit
+			if err := json.Unmarshal(data, &submit); err != nil {
+				return err
+			}
+			if !submit.Resolved {
+				pending = append(pending, &submit)
+			}
+			return nil
+		})
+	})
+	return pending, err
+}
+
+func (s *BoltNonceStore) Get(clientOrderId string) (*PendingSubmit, bool, error) {
+	var submit *PendingSubmit
+	err := s.db.View(func(tx *bolt.Tx) error {
+		data := tx.Bucket(pendingSubmitsBucket).Get([]byte(clientOrderId))
+		if data == nil {
+			return nil
+		}
+		submit = &PendingSubmit{}
+		return json.Unmarshal(data, submit)
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return submit, submit != nil, nil
+}
@@ -0,0 +1,127 @@
+package order
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestInMemoryNonceStoreSaveAndGet(t *testing.T) {
+	store := NewInMemoryNonceStore()
+
+	submit := &PendingSubmit{ClientOrderId: "abc", Nonce: 1, Body: map[string]interface{}{"x": 1}}
+	if err := store.Save(submit); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	got, ok, err := store.Get("abc")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !ok {
+		t.Fatalf("expected Get to find the saved submission")
+	}
+	if got.Nonce != 1 || got.Resolved {
+		t.Fatalf("unexpected submission returned: %+v", got)
+	}
+}
+
+func TestInMemoryNonceStoreGetMissing(t *testing.T) {
+	store := NewInMemoryNonceStore()
+
+	_, ok, err := store.Get("missing")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if ok {
+		t.Fatalf("expected Get to report no submission for an unknown clientOrderId")
+	}
+}
+
+func TestInMemoryNonceStoreGetReturnsACopy(t *testing.T) {
+	store := NewInMemoryNonceStore()
+	if err := store.Save(&PendingSubmit{ClientOrderId: "abc"}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	got, _, err := store.Get("abc")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	got.Resolved = true
+
+	again, _, err := store.Get("abc")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if again.Resolved {
+		t.Fatalf("mutating the value returned by Get must not affect the store's copy")
+	}
+}
+
+func TestInMemoryNonceStoreMarkResolved(t *testing.T) {
+	store := NewInMemoryNonceStore()
+	if err := store.Save(&PendingSubmit{ClientOrderId: "abc"}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	if err := store.MarkResolved("abc"); err != nil {
+		t.Fatalf("MarkResolved returned error: %v", err)
+	}
+
+	got, ok, err := store.Get("abc")
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if !ok || !got.Resolved {
+		t.Fatalf("expected submission to be resolved, got %+v (ok=%v)", got, ok)
+	}
+
+	// MarkResolved on an id the store has never seen is a no-op, not an error.
+	if err := store.MarkResolved("never-saved"); err != nil {
+		t.Fatalf("MarkResolved on an unknown id returned error: %v", err)
+	}
+}
+
+func TestInMemoryNonceStorePendingExcludesResolved(t *testing.T) {
+	store := NewInMemoryNonceStore()
+	if err := store.Save(&PendingSubmit{ClientOrderId: "resolved"}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if err := store.Save(&PendingSubmit{ClientOrderId: "unresolved"}); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if err := store.MarkResolved("resolved"); err != nil {
+		t.Fatalf("MarkResolved returned error: %v", err)
+	}
+
+	pending, err := store.Pending()
+	if err != nil {
+		t.Fatalf("Pending returned error: %v", err)
+	}
+	if len(pending) != 1 || pending[0].ClientOrderId != "unresolved" {
+		t.Fatalf("expected only the unresolved submission, got %+v", pending)
+	}
+}
+
+// TestInMemoryNonceStoreConcurrentAccess exercises Save/Get/MarkResolved/
+// Pending from many goroutines at once; run with -race to catch any data
+// race in the store itself (Get's copy-under-lock in particular).
+func TestInMemoryNonceStoreConcurrentAccess(t *testing.T) {
+	store := NewInMemoryNonceStore()
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := "id"
+			_ = store.Save(&PendingSubmit{ClientOrderId: id, Nonce: int64(i)})
+			_, _, _ = store.Get(id)
+			_ = store.MarkResolved(id)
+			_, _ = store.Pending()
+		}()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,51 @@
+package order
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResumePendingSubmits re-queries the exchange for every submission the
+// NonceStore has not yet resolved, so a process that crashed or lost its
+// connection between signing an order and receiving its response can find
+// out whether the order was actually accepted instead of blindly retrying
+// (and orphaning the nonce) or blindly assuming it failed (and double
+// placing it).
+func (c *Client) ResumePendingSubmits(ctx context.Context) ([]*Order, error) {
+	pending, err := c.nonceStore.Pending()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending submits: %w", err)
+	}
+	if len(pending) == 0 {
+		return nil, nil
+	}
+
+	clientOrderIds := make([]string, len(pending))
+	for i, submit := range pending {
+		clientOrderIds[i] = submit.ClientOrderId
+	}
+
+	found, err := c.GetOrdersByClientOrderID(ctx, clientOrderIds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reconcile pending submits: %w", err)
+	}
+
+	resolved := make(map[string]struct{}, len(found.DataList))
+	for i := range found.DataList {
+		resolved[found.DataList[i].ClientOrderId] = struct{}{}
+	}
+
+	for _, submit := range pending {
+		if _, ok := resolved[submit.ClientOrderId]; ok {
+			if err := c.nonceStore.MarkResolved(submit.ClientOrderId); err != nil {
+				return nil, fmt.Errorf("failed to mark %s resolved: %w", submit.ClientOrderId, err)
+			}
+		}
+	}
+
+	results := make([]*Order, len(found.DataList))
+	for i := range found.DataList {
+		results[i] = &found.DataList[i]
+	}
+	return results, nil
+}
@@ -0,0 +1,174 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/edgex-Tech/edgex-golang-sdk/sdk/internal"
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// orderSigningInputs is the set of contract-derived values needed to
+// compute a StarkEx order hash, shared by the plain and conditional order
+// signing paths so they stay consistent with each other.
+type orderSigningInputs struct {
+	contract         *metadatapkg.Contract
+	quoteCoin        *metadatapkg.Coin
+	size             decimal.Decimal
+	valueDm          decimal.Decimal
+	amountSynthetic  int64
+	amountCollateral int64
+	limitFee         decimal.Decimal
+	maxAmountFee     decimal.Decimal
+}
+
+// prepareOrderSigningInputs resolves the contract and quote coin for
+// contractId, parses size, and computes the StarkEx-scaled amounts and fee
+// that go into an order's signable hash.
+func prepareOrderSigningInputs(metadata *metadatapkg.MetaData, contractId string, sizeStr string, l2Price decimal.Decimal) (*orderSigningInputs, error) {
+	contract, err := findContract(metadata, contractId)
+	if err != nil {
+		return nil, err
+	}
+
+	var quoteCoin *metadatapkg.Coin
+	if metadata != nil && metadata.CoinList != nil {
+		for i := range metadata.CoinList {
+			if metadata.CoinList[i].CoinId == contract.QuoteCoinId {
+				quoteCoin = &metadata.CoinList[i]
+				break
+			}
+		}
+	}
+	if quoteCoin == nil {
+		return nil, fmt.Errorf("coin not found: %s", contract.QuoteCoinId)
+	}
+
+	syntheticFactorBig, err := internal.HexToBigInteger(contract.StarkExResolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse synthetic factor: %w", err)
+	}
+	syntheticFactor := decimal.NewFromBigInt(syntheticFactorBig, 0)
+
+	shiftFactorBig, err := internal.HexToBigInteger(quoteCoin.StarkExResolution)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse shift factor: %w", err)
+	}
+	shiftFactor := decimal.NewFromBigInt(shiftFactorBig, 0)
+
+	size, err := decimal.NewFromString(sizeStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse size: %w", err)
+	}
+
+	valueDm := l2Price.Mul(size)
+	amountSynthetic := size.Mul(syntheticFactor).IntPart()
+	amountCollateral := valueDm.Mul(shiftFactor).IntPart()
+
+	var feeRate decimal.Decimal
+	if contract.DefaultTakerFeeRate != "" {
+		feeRateVal, err := decimal.NewFromString(contract.DefaultTakerFeeRate)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse fee rate: %w", err)
+		}
+		feeRate = feeRateVal
+	} else {
+		feeRate, _ = decimal.NewFromString("0.001") // Default fee rate
+	}
+
+	limitFee := size.Mul(l2Price).Mul(feeRate).Ceil()
+	maxAmountFee := limitFee.Mul(shiftFactor)
+
+	return &orderSigningInputs{
+		contract:         contract,
+		quoteCoin:        quoteCoin,
+		size:             size,
+		valueDm:          valueDm,
+		amountSynthetic:  amountSynthetic,
+		amountCollateral: amountCollateral,
+		limitFee:         limitFee,
+		maxAmountFee:     maxAmountFee,
+	}, nil
+}
+
+// signAndBuildOrderBody validates params against the contract's limits,
+// computes the StarkEx limit order hash, signs it, records the submission
+// in the NonceStore, and returns the request body ready to POST. If
+// clientOrderId is empty, a fresh one is generated; callers that need to
+// reserve collision-free IDs up front (e.g. a batch) should generate one
+// and pass it in instead.
+//
+// This is the single signing path shared by CreateOrder and the batch
+// CreateOrders call, so both stay subject to the same tick/step/notional
+// validation and the same NonceStore bookkeeping.
+func (c *Client) signAndBuildOrderBody(ctx context.Context, params *CreateOrderParams, metadata *metadatapkg.MetaData, l2Price decimal.Decimal, clientOrderId string) (map[string]interface{}, string, error) {
+	inputs, err := prepareOrderSigningInputs(metadata, params.ContractId, params.Size, l2Price)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if validationErr := validateOrderParams(inputs.contract, l2Price, inputs.size); validationErr != nil {
+		return nil, "", validationErr
+	}
+
+	if clientOrderId == "" {
+		clientOrderId = internal.GetRandomClientId()
+	}
+
+	nonce := internal.CalcNonce(clientOrderId)
+	l2ExpireTime := params.ExpireTime.Add(time.Hour * 9 * 24).UnixMilli()
+	l2ExpireHour := l2ExpireTime / (60 * 60 * 1000)
+
+	msgHash := internal.CalcLimitOrderHash(
+		inputs.contract.StarkExSyntheticAssetId,
+		inputs.quoteCoin.StarkExAssetId,
+		inputs.quoteCoin.StarkExAssetId,
+		params.Side == "BUY",
+		inputs.amountSynthetic,
+		inputs.amountCollateral,
+		inputs.maxAmountFee.BigInt().Int64(),
+		nonce,
+		c.Client.GetAccountID(),
+		l2ExpireHour,
+	)
+	signature, err := c.Client.Sign(msgHash)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to sign order hash: %w", err)
+	}
+	sigStr := fmt.Sprintf("%s%s%s", signature.R, signature.S, signature.V)
+
+	body := map[string]interface{}{
+		"accountId":     strconv.FormatInt(c.Client.GetAccountID(), 10),
+		"contractId":    params.ContractId,
+		"price":         params.Price,
+		"size":          params.Size,
+		"type":          string(params.Type),
+		"side":          params.Side,
+		"timeInForce":   params.TimeInForce,
+		"clientOrderId": clientOrderId,
+		"expireTime":    strconv.FormatInt(params.ExpireTime.UnixMilli(), 10),
+		"l2Nonce":       strconv.FormatInt(nonce, 10),
+		"l2Signature":   sigStr,
+		"l2ExpireTime":  strconv.FormatInt(l2ExpireTime, 10),
+		"l2Value":       inputs.valueDm.String(),
+		"l2Size":        params.Size,
+		"l2LimitFee":    inputs.limitFee.String(),
+		"reduceOnly":    params.ReduceOnly,
+	}
+
+	if err := c.nonceStore.Save(&PendingSubmit{
+		ClientOrderId: clientOrderId,
+		Nonce:         nonce,
+		L2ExpireTime:  l2ExpireTime,
+		MsgHash:       fmt.Sprintf("%v", msgHash),
+		Signature:     sigStr,
+		Body:          body,
+	}); err != nil {
+		return nil, "", fmt.Errorf("failed to persist pending submit: %w", err)
+	}
+
+	return body, clientOrderId, nil
+}
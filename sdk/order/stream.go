@@ -0,0 +1,358 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// OrderUpdate is emitted whenever an order's status changes on the private
+// order/fill stream.
+type OrderUpdate struct {
+	OrderId       string `json:"orderId"`
+	ClientOrderId string `json:"clientOrderId"`
+	ContractId    string `json:"contractId"`
+	Status        string `json:"status"`
+	Seq           uint64 `json:"seq"`
+}
+
+// FillUpdate is emitted for each fill against an order on the private
+// order/fill stream.
+type FillUpdate struct {
+	OrderId       string `json:"orderId"`
+	ClientOrderId string `json:"clientOrderId"`
+	ContractId    string `json:"contractId"`
+	FillSize      string `json:"fillSize"`
+	FillPrice     string `json:"fillPrice"`
+	Seq           uint64 `json:"seq"`
+}
+
+// PositionUpdate is emitted whenever a position's size or margin changes as
+// a side effect of an order or fill on the private stream.
+type PositionUpdate struct {
+	ContractId string `json:"contractId"`
+	OpenSize   string `json:"openSize"`
+	Seq        uint64 `json:"seq"`
+}
+
+// orderCacheEntry is the StreamClient's local view of one order, looked up
+// by either OrderId or ClientOrderId.
+type orderCacheEntry struct {
+	order *Order
+	final bool
+}
+
+// StreamClient maintains a live connection to the private order/fill
+// WebSocket stream and a local cache of active orders, so strategies can
+// react to fills without polling GetOrdersByClientOrderID in a loop.
+type StreamClient struct {
+	client *Client
+
+	OrderUpdates    chan OrderUpdate
+	FillUpdates     chan FillUpdate
+	PositionUpdates chan PositionUpdate
+
+	mu            sync.RWMutex
+	byOrderId     map[string]*orderCacheEntry
+	byClientId    map[string]*orderCacheEntry
+	lastSeq       uint64
+	waiters       map[string][]chan *Order
+	conn          *websocket.Conn
+	subscriptions map[string]struct{}
+}
+
+// NewStreamClient creates a StreamClient bound to the given order client.
+// Call Connect to establish the WebSocket session.
+func NewStreamClient(client *Client) *StreamClient {
+	return &StreamClient{
+		client:          client,
+		OrderUpdates:    make(chan OrderUpdate, 256),
+		FillUpdates:     make(chan FillUpdate, 256),
+		PositionUpdates: make(chan PositionUpdate, 256),
+		byOrderId:       make(map[string]*orderCacheEntry),
+		byClientId:      make(map[string]*orderCacheEntry),
+		waiters:         make(map[string][]chan *Order),
+		subscriptions:   make(map[string]struct{}),
+	}
+}
+
+// Connect opens the private WebSocket stream, authenticates with the
+// account's L2 credentials, seeds the local order cache from
+// GetActiveOrders, and starts the background read loop that fans updates
+// out onto OrderUpdates/FillUpdates/PositionUpdates.
+func (s *StreamClient) Connect(ctx context.Context) error {
+	if err := s.seedCache(ctx); err != nil {
+		return fmt.Errorf("failed to seed order cache: %w", err)
+	}
+
+	conn, err := s.dial(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to order stream: %w", err)
+	}
+
+	s.mu.Lock()
+	s.conn = conn
+	s.mu.Unlock()
+
+	s.replaySubscriptions(conn)
+
+	go s.readLoop(ctx)
+
+	return nil
+}
+
+// replaySubscriptions re-sends a subscribe message for every contract in
+// s.subscriptions over conn, so subscriptions registered before Connect (or
+// before a reconnect) still take effect on the new session.
+func (s *StreamClient) replaySubscriptions(conn *websocket.Conn) {
+	s.mu.RLock()
+	subs := make([]string, 0, len(s.subscriptions))
+	for contractId := range s.subscriptions {
+		subs = append(subs, contractId)
+	}
+	s.mu.RUnlock()
+
+	for _, contractId := range subs {
+		_ = conn.WriteJSON(map[string]interface{}{
+			"type":       "subscribe",
+			"channel":    "order",
+			"contractId": contractId,
+		})
+	}
+}
+
+func (s *StreamClient) dial(ctx context.Context) (*websocket.Conn, error) {
+	url := fmt.Sprintf("%s/api/v1/private/ws/order", s.client.Client.GetWsURL())
+	header := s.client.Client.AuthHeader()
+
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// seedCache populates the local order index from the account's currently
+// active orders.
+func (s *StreamClient) seedCache(ctx context.Context) error {
+	page, err := s.client.GetActiveOrders(ctx, &GetActiveOrderParams{})
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i := range page.DataList {
+		order := &page.DataList[i]
+		entry := &orderCacheEntry{order: order}
+		s.byOrderId[order.OrderId] = entry
+		s.byClientId[order.ClientOrderId] = entry
+	}
+	return nil
+}
+
+// Subscribe arms the stream to receive updates for contractId. It is safe
+// to call before or after Connect; subscriptions are replayed on reconnect.
+func (s *StreamClient) Subscribe(contractId string) error {
+	s.mu.Lock()
+	s.subscriptions[contractId] = struct{}{}
+	conn := s.conn
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return conn.WriteJSON(map[string]interface{}{
+		"type":       "subscribe",
+		"channel":    "order",
+		"contractId": contractId,
+	})
+}
+
+// WaitForFinal blocks until clientOrderId reaches a final status (filled,
+// canceled, or rejected) or ctx is done, and returns the order's last known
+// state.
+func (s *StreamClient) WaitForFinal(ctx context.Context, clientOrderId string) (*Order, error) {
+	s.mu.Lock()
+	if entry, ok := s.byClientId[clientOrderId]; ok && entry.final {
+		s.mu.Unlock()
+		return entry.order, nil
+	}
+	ch := make(chan *Order, 1)
+	s.waiters[clientOrderId] = append(s.waiters[clientOrderId], ch)
+	s.mu.Unlock()
+
+	select {
+	case order := <-ch:
+		return order, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// readLoop consumes messages from the WebSocket connection until it closes,
+// then reconnects and reconciles the cache from the last seen sequence
+// number.
+func (s *StreamClient) readLoop(ctx context.Context) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		s.mu.RLock()
+		conn := s.conn
+		s.mu.RUnlock()
+		if conn == nil {
+			return
+		}
+
+		_, raw, err := conn.ReadMessage()
+		if err != nil {
+			s.reconnect(ctx)
+			continue
+		}
+
+		var envelope struct {
+			Channel string          `json:"channel"`
+			Seq     uint64          `json:"seq"`
+			Data    json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(raw, &envelope); err != nil {
+			continue
+		}
+
+		switch envelope.Channel {
+		case "order":
+			var update OrderUpdate
+			if err := json.Unmarshal(envelope.Data, &update); err == nil {
+				s.handleOrderUpdate(update)
+			}
+		case "fill":
+			var update FillUpdate
+			if err := json.Unmarshal(envelope.Data, &update); err == nil {
+				s.handleFillUpdate(update)
+			}
+		case "position":
+			var update PositionUpdate
+			if err := json.Unmarshal(envelope.Data, &update); err == nil {
+				s.handlePositionUpdate(update)
+			}
+		}
+
+		s.mu.Lock()
+		if envelope.Seq > s.lastSeq {
+			s.lastSeq = envelope.Seq
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *StreamClient) handleOrderUpdate(update OrderUpdate) {
+	final := update.Status == "FILLED" || update.Status == "CANCELED" || update.Status == "REJECTED"
+
+	s.mu.Lock()
+	entry, ok := s.byOrderId[update.OrderId]
+	if !ok {
+		entry = &orderCacheEntry{}
+		s.byOrderId[update.OrderId] = entry
+		s.byClientId[update.ClientOrderId] = entry
+	}
+	entry.order = &Order{OrderId: update.OrderId, ClientOrderId: update.ClientOrderId, ContractId: update.ContractId, Status: update.Status}
+	entry.final = final
+
+	var waiters []chan *Order
+	if final {
+		waiters = s.waiters[update.ClientOrderId]
+		delete(s.waiters, update.ClientOrderId)
+	}
+	s.mu.Unlock()
+
+	for _, ch := range waiters {
+		ch <- entry.order
+	}
+
+	select {
+	case s.OrderUpdates <- update:
+	default:
+	}
+}
+
+func (s *StreamClient) handleFillUpdate(update FillUpdate) {
+	select {
+	case s.FillUpdates <- update:
+	default:
+	}
+}
+
+func (s *StreamClient) handlePositionUpdate(update PositionUpdate) {
+	select {
+	case s.PositionUpdates <- update:
+	default:
+	}
+}
+
+// reconnect re-dials the WebSocket and reconciles the local cache by
+// re-fetching active orders and any fills seen since the last processed
+// sequence number.
+func (s *StreamClient) reconnect(ctx context.Context) {
+	for attempt := 0; ; attempt++ {
+		if ctx.Err() != nil {
+			return
+		}
+
+		conn, err := s.dial(ctx)
+		if err != nil {
+			time.Sleep(backoff(attempt))
+			continue
+		}
+
+		s.mu.Lock()
+		s.conn = conn
+		lastSeq := s.lastSeq
+		s.mu.Unlock()
+
+		s.replaySubscriptions(conn)
+
+		if err := s.seedCache(ctx); err != nil {
+			// conn was already dialed and stored in s.conn above; close it
+			// before retrying so a reconcile failure after a successful
+			// dial doesn't leak the socket on every retry.
+			conn.Close()
+			time.Sleep(backoff(attempt))
+			continue
+		}
+
+		_ = conn.WriteJSON(map[string]interface{}{
+			"type":     "resync",
+			"channel":  "order",
+			"sinceSeq": strconv.FormatUint(lastSeq, 10),
+		})
+
+		return
+	}
+}
+
+func backoff(attempt int) time.Duration {
+	d := time.Duration(attempt) * 500 * time.Millisecond
+	if d > 10*time.Second {
+		return 10 * time.Second
+	}
+	return d
+}
+
+// Close tears down the WebSocket connection.
+func (s *StreamClient) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
@@ -0,0 +1,104 @@
+package order
+
+import (
+	"context"
+	"fmt"
+
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+// OrderValidationError reports that an order parameter violates a contract
+// constraint (tick size, step size, min notional, or max order size) before
+// the order is ever signed, so a retry can snap to NearestLegal instead of
+// burning a nonce on a server-side rejection.
+type OrderValidationError struct {
+	Field        string
+	Value        decimal.Decimal
+	NearestLegal decimal.Decimal
+	Reason       string
+}
+
+func (e *OrderValidationError) Error() string {
+	return fmt.Sprintf("invalid %s %s: %s (nearest legal value: %s)", e.Field, e.Value.String(), e.Reason, e.NearestLegal.String())
+}
+
+// RoundPriceToTick rounds price to the nearest multiple of the contract's
+// tick size. If the contract has no tick size configured, price is returned
+// unchanged.
+func RoundPriceToTick(contract *metadatapkg.Contract, price decimal.Decimal) decimal.Decimal {
+	return roundToTick(contract, price)
+}
+
+// RoundSizeToStep rounds size to the nearest multiple of the contract's
+// quantity step. If the contract has no step size configured, size is
+// returned unchanged.
+func RoundSizeToStep(contract *metadatapkg.Contract, size decimal.Decimal) decimal.Decimal {
+	return roundToStep(contract, size)
+}
+
+// validateOrderParams checks price and size against the contract's tick
+// size, step size, and min notional. It is purely local (no network calls)
+// so it runs unconditionally on every order, including the 2*NumLayers
+// concurrent orders PlaceLiquidityLayers fans out. It returns the first
+// violation found, or nil if params is within bounds.
+//
+// It deliberately does not check the account's max order size: that
+// requires a GetMaxOrderSize round-trip per call, which would add a
+// server round-trip to every CreateOrder. Callers that want that check
+// should call CheckMaxOrderSize explicitly before submitting.
+func validateOrderParams(contract *metadatapkg.Contract, price, size decimal.Decimal) *OrderValidationError {
+	if tickSize, err := decimal.NewFromString(contract.TickSize); err == nil && !tickSize.IsZero() {
+		snapped := RoundPriceToTick(contract, price)
+		if !snapped.Equal(price) {
+			return &OrderValidationError{Field: "price", Value: price, NearestLegal: snapped, Reason: fmt.Sprintf("not a multiple of tick size %s", tickSize.String())}
+		}
+	}
+
+	if stepSize, err := decimal.NewFromString(contract.StepSize); err == nil && !stepSize.IsZero() {
+		snapped := RoundSizeToStep(contract, size)
+		if !snapped.Equal(size) {
+			return &OrderValidationError{Field: "size", Value: size, NearestLegal: snapped, Reason: fmt.Sprintf("not a multiple of quantity step %s", stepSize.String())}
+		}
+	}
+
+	notional := price.Mul(size)
+	if contract.MinNotional != "" {
+		if minNotional, err := decimal.NewFromString(contract.MinNotional); err == nil && notional.LessThan(minNotional) {
+			nearestLegal := decimal.Zero
+			if price.IsPositive() {
+				nearestLegal = minNotional.Div(price)
+			}
+			return &OrderValidationError{Field: "size", Value: size, NearestLegal: nearestLegal, Reason: fmt.Sprintf("order notional %s is below contract minimum %s", notional.String(), minNotional.String())}
+		}
+	}
+
+	return nil
+}
+
+// CheckMaxOrderSize queries the account's current max order size for
+// contract at price and returns a validation error if size exceeds it. It
+// is a separate, opt-in call rather than part of validateOrderParams
+// because it requires a GetMaxOrderSize round-trip: callers placing many
+// orders in quick succession (e.g. PlaceLiquidityLayers) should call this
+// themselves only where that cost is acceptable, rather than pay it on
+// every single order. A GetMaxOrderSize failure is returned as an error
+// rather than treated as "no limit" so callers don't silently bypass a
+// check they asked for.
+func (c *Client) CheckMaxOrderSize(ctx context.Context, contract *metadatapkg.Contract, price, size decimal.Decimal) error {
+	maxSize, err := c.GetMaxOrderSize(ctx, contract.ContractId, price)
+	if err != nil {
+		return fmt.Errorf("failed to fetch max order size: %w", err)
+	}
+	if maxSize == nil || maxSize.MaxSize == "" {
+		return nil
+	}
+	limit, err := decimal.NewFromString(maxSize.MaxSize)
+	if err != nil {
+		return fmt.Errorf("failed to parse max order size %q: %w", maxSize.MaxSize, err)
+	}
+	if size.GreaterThan(limit) {
+		return &OrderValidationError{Field: "size", Value: size, NearestLegal: limit, Reason: "order size exceeds the account's max order size for this contract"}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package order
+
+import (
+	"testing"
+
+	metadatapkg "github.com/edgex-Tech/edgex-golang-sdk/sdk/metadata"
+	"github.com/shopspring/decimal"
+)
+
+func TestValidateOrderParamsOK(t *testing.T) {
+	contract := &metadatapkg.Contract{
+		TickSize:    "0.5",
+		StepSize:    "0.01",
+		MinNotional: "10",
+	}
+
+	if err := validateOrderParams(contract, decimal.NewFromFloat(100.5), decimal.NewFromFloat(1.00)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+}
+
+func TestValidateOrderParamsRejectsOffTickPrice(t *testing.T) {
+	contract := &metadatapkg.Contract{TickSize: "0.5"}
+
+	err := validateOrderParams(contract, decimal.NewFromFloat(100.3), decimal.NewFromInt(1))
+	if err == nil {
+		t.Fatalf("expected a validation error")
+	}
+	verr, ok := err.(*OrderValidationError)
+	if !ok {
+		t.Fatalf("expected *OrderValidationError, got %T", err)
+	}
+	if verr.Field != "price" {
+		t.Fatalf("expected field 'price', got %q", verr.Field)
+	}
+	if !verr.NearestLegal.Equal(decimal.NewFromFloat(100.5)) {
+		t.Fatalf("expected nearest legal 100.5, got %s", verr.NearestLegal.String())
+	}
+}
+
+func TestValidateOrderParamsRejectsOffStepSize(t *testing.T) {
+	contract := &metadatapkg.Contract{StepSize: "0.1"}
+
+	err := validateOrderParams(contract, decimal.NewFromInt(100), decimal.NewFromFloat(1.23))
+	if err == nil {
+		t.Fatalf("expected a validation error")
+	}
+	verr := err.(*OrderValidationError)
+	if verr.Field != "size" {
+		t.Fatalf("expected field 'size', got %q", verr.Field)
+	}
+}
+
+func TestValidateOrderParamsRejectsBelowMinNotional(t *testing.T) {
+	contract := &metadatapkg.Contract{MinNotional: "100"}
+
+	err := validateOrderParams(contract, decimal.NewFromInt(10), decimal.NewFromInt(1))
+	if err == nil {
+		t.Fatalf("expected a validation error")
+	}
+	verr := err.(*OrderValidationError)
+	if verr.Field != "size" {
+		t.Fatalf("expected field 'size', got %q", verr.Field)
+	}
+	if !verr.NearestLegal.Equal(decimal.NewFromInt(10)) {
+		t.Fatalf("expected nearest legal size 10, got %s", verr.NearestLegal.String())
+	}
+}
+
+func TestRoundPriceAndSizeToTick(t *testing.T) {
+	contract := &metadatapkg.Contract{TickSize: "0.25", StepSize: "0.5"}
+
+	if got := RoundPriceToTick(contract, decimal.NewFromFloat(10.1)); !got.Equal(decimal.NewFromFloat(10.0)) {
+		t.Fatalf("expected 10.0, got %s", got.String())
+	}
+	if got := RoundSizeToStep(contract, decimal.NewFromFloat(1.3)); !got.Equal(decimal.NewFromFloat(1.5)) {
+		t.Fatalf("expected 1.5, got %s", got.String())
+	}
+}